@@ -11,12 +11,78 @@ type DistanceFn[T any] func(a T, b T) float64
 type MeanFn[T any] func(n []T) T
 type EqualsFn[T any] func(a T, b T) bool
 
+// SeedStrategy selects the algorithm used by initClusters to choose the initial centroids.
+type SeedStrategy int
+
+const (
+	// SeedKMeansPlusPlus is the default strategy: a single sequential pass that samples each
+	// successive center with probability proportional to D(x)2.
+	SeedKMeansPlusPlus SeedStrategy = iota
+
+	// SeedKMeansParallel is Bahmani et al.'s k-means|| algorithm, which oversamples a small pool
+	// of candidate centers in O(log n) parallel rounds and reduces them to k via a local weighted
+	// k-means++ pass. It trades a slightly weaker seeding guarantee for far fewer sequential passes
+	// over the data, which matters once len(data) is large.
+	SeedKMeansParallel
+)
+
+// Option configures a KMeans instance at construction time.
+type Option[T any] func(*KMeans[T])
+
+// WithSeedStrategy selects the initial-centroid algorithm used by Calculate. The default is
+// SeedKMeansPlusPlus.
+func WithSeedStrategy[T any](strategy SeedStrategy) Option[T] {
+	return func(k *KMeans[T]) {
+		k.seedStrategy = strategy
+	}
+}
+
+// WithOversamplingFactor sets the oversampling factor l used by SeedKMeansParallel: each round
+// samples a point with probability min(1, l*D(x)2/phi). It has no effect with other strategies.
+// If unset (or <= 0), it defaults to 2*numClusters.
+func WithOversamplingFactor[T any](l float64) Option[T] {
+	return func(k *KMeans[T]) {
+		k.oversampling = l
+	}
+}
+
+// WithSeedRounds sets the number of oversampling rounds performed by SeedKMeansParallel. It has
+// no effect with other strategies. If unset (or <= 0), it defaults to 5.
+func WithSeedRounds[T any](rounds int) Option[T] {
+	return func(k *KMeans[T]) {
+		k.rounds = rounds
+	}
+}
+
+// IncrementalMeanFn lets the update step combine a cluster's points in parallel instead of
+// calling MeanFn once over the full node slice. Add folds a point (or another partial sum
+// produced by Add) into an accumulator of the same type, and Finalize turns the combined
+// accumulator plus the total point count into the final centroid. Implementing this only makes
+// sense when T's "sum" is itself representable as a T, e.g. an un-normalized vec.Point.
+type IncrementalMeanFn[T any] interface {
+	Add(acc T, x T) T
+	Finalize(acc T, count int) T
+}
+
+// WithIncrementalMeanFn enables parallel centroid updates via fn. It has no effect unless fn is
+// non-nil.
+func WithIncrementalMeanFn[T any](fn IncrementalMeanFn[T]) Option[T] {
+	return func(k *KMeans[T]) {
+		k.incrementalMean = fn
+	}
+}
+
 type KMeans[T any] struct {
-	data     []T
-	distance DistanceFn[T]
-	mean     MeanFn[T]
-	equals   EqualsFn[T]
-	rnd      *rand.Rand
+	data            []T
+	distance        DistanceFn[T]
+	mean            MeanFn[T]
+	equals          EqualsFn[T]
+	rnd             *rand.Rand
+	seedStrategy    SeedStrategy
+	oversampling    float64
+	rounds          int
+	randomPoint     RandomPointFn[T]
+	incrementalMean IncrementalMeanFn[T]
 }
 
 type Cluster[T any] struct {
@@ -24,73 +90,70 @@ type Cluster[T any] struct {
 	Nodes    []T
 }
 
-func NewKMeans[T any](data []T, distance DistanceFn[T], mean MeanFn[T], equals EqualsFn[T]) (*KMeans[T], error) {
+func NewKMeans[T any](data []T, distance DistanceFn[T], mean MeanFn[T], equals EqualsFn[T], opts ...Option[T]) (*KMeans[T], error) {
 	if len(data) == 0 {
 		return nil, errors.New("no observations provided")
 	}
 
-	return &KMeans[T]{
+	k := &KMeans[T]{
 		data:     data,
 		distance: distance,
 		mean:     mean,
 		equals:   equals,
 		rnd:      rand.New(rand.NewSource(time.Now().UnixNano())),
-	}, nil
-}
-
-func (k *KMeans[T]) Calculate(numClusters uint) []Cluster[T] {
-	clusters := k.initClusters(numClusters)
+	}
 
-	k.partition(clusters)
+	for _, opt := range opts {
+		opt(k)
+	}
 
-	return clusters
+	return k, nil
 }
 
-func (k *KMeans[T]) initClusters(numClusters uint) []Cluster[T] {
-	var clusters []Cluster[T]
-
-	// 1 -	Choose one center uniformly at random among the data points.
-	idx := []int{k.rnd.Intn(len(k.data))}
-	clusters = append(clusters, Cluster[T]{Centroid: k.data[idx[0]]})
+// StopCriteria controls when Calculate stops iterating. A zero-valued field is treated as
+// "unset" and does not contribute to the stopping decision; Calculate always stops once the
+// centroids stop moving, regardless of StopCriteria.
+type StopCriteria struct {
+	// MaxIterations stops Calculate once this many iterations have run, win or lose.
+	MaxIterations int
 
-	// 2 -	For each data point x not chosen yet, compute D(x), the distance between x and the nearest center that
-	//		has already been chosen.
-	for uint(len(clusters)) < numClusters {
-		nodeDistance := -1.0
-		nodeIdx := -1
+	// Tolerance stops Calculate once the total centroid shift (the sum, over all clusters, of
+	// the distance each centroid moved) falls below this value.
+	Tolerance float64
 
-		for i := 0; i < len(k.data); i++ {
-			if in(i, idx) {
-				continue
-			}
-
-			nearestCluster := clusters[k.nearestCluster(k.data[i], clusters)]
-			nearestClusterDistance := k.distance(k.data[i], nearestCluster.Centroid)
+	// MinReassignments stops Calculate once fewer than this many points changed cluster during
+	// an iteration.
+	MinReassignments int
+}
 
-			if nearestClusterDistance > nodeDistance {
-				nodeDistance = nearestClusterDistance
-				nodeIdx = i
-			}
-		}
+// TerminationReason records which StopCriteria field (if any) ended Calculate.
+type TerminationReason int
 
-		// 3 -	Choose one new data point at random as a new center, using a weighted probability distribution where a
-		//		point x is chosen with probability proportional to D(x)2.
-		idx = append(idx, nodeIdx)
-		clusters = append(clusters, Cluster[T]{Centroid: k.data[nodeIdx]})
-	} // 4 - Repeat Steps 2 and 3 until k centers have been chosen.
+const (
+	// TerminatedConverged means the centroids stopped moving entirely.
+	TerminatedConverged TerminationReason = iota
+	TerminatedMaxIterations
+	TerminatedTolerance
+	TerminatedMinReassignments
+)
 
-	// 5 -	Now that the initial centers have been chosen, proceed using standard k-means clustering.
-	return clusters
+// Info reports how Calculate's iteration loop ended.
+type Info struct {
+	Iterations int
+	Inertia    float64
+	Reason     TerminationReason
 }
 
-func in(i int, p []int) bool {
-	for _, s := range p {
-		if s == i {
-			return true
-		}
+func (k *KMeans[T]) Calculate(numClusters uint, stop StopCriteria) ([]Cluster[T], Info, error) {
+	if numClusters == 0 || int(numClusters) > len(k.data) {
+		return nil, Info{}, errors.New("numClusters must be between 1 and len(data)")
 	}
 
-	return false
+	clusters := k.initClusters(numClusters)
+
+	info := k.partition(clusters, stop)
+
+	return clusters, info, nil
 }
 
 func (k *KMeans[T]) nearestCluster(node T, clusters []Cluster[T]) int {
@@ -109,25 +172,87 @@ func (k *KMeans[T]) nearestCluster(node T, clusters []Cluster[T]) int {
 	return idx
 }
 
-func (k *KMeans[T]) partition(clusters []Cluster[T]) {
-	changed := true
+func (k *KMeans[T]) partition(clusters []Cluster[T], stop StopCriteria) Info {
+	// assignments[i] is the index of the cluster k.data[i] belonged to in the previous iteration,
+	// so we can count how many points changed cluster this iteration. -1 means unassigned.
+	assignments := make([]int, len(k.data))
+	for i := range assignments {
+		assignments[i] = -1
+	}
+
+	iterations := 0
+	reason := TerminatedConverged
+
+	for {
+		iterations++
 
-	for changed {
 		// 1 -	Assignment
-		for i := 0; i < len(k.data); i++ {
-			nearestClusterIdx := k.nearestCluster(k.data[i], clusters)
-			clusters[nearestClusterIdx].Nodes = append(clusters[nearestClusterIdx].Nodes, k.data[i])
+		for i := range clusters {
+			clusters[i].Nodes = clusters[i].Nodes[:0]
 		}
 
-		changedInIteration := false
+		reassigned := k.assign(clusters, assignments)
 
 		// 2 -	Update
+		moved := false
+		shift := 0.0
+
 		for i := 0; i < len(clusters); i++ {
+			// A cluster can lose every point to a duplicate centroid elsewhere (nearestCluster's
+			// strict "<" sends ties to the lower index). Keep its previous centroid rather than
+			// calling MeanFn/IncrementalMeanFn on an empty slice.
+			if len(clusters[i].Nodes) == 0 {
+				continue
+			}
+
 			pc := clusters[i].Centroid
-			clusters[i].Centroid = k.mean(clusters[i].Nodes)
-			changedInIteration = changedInIteration || k.equals(pc, clusters[i].Centroid)
+			clusters[i].Centroid = k.updateCentroid(clusters[i].Nodes)
+
+			if !k.equals(pc, clusters[i].Centroid) {
+				moved = true
+				shift += k.distance(pc, clusters[i].Centroid)
+			}
 		}
 
-		changed = changedInIteration
+		if stop.MaxIterations > 0 && iterations >= stop.MaxIterations {
+			reason = TerminatedMaxIterations
+			break
+		}
+
+		if stop.MinReassignments > 0 && reassigned < stop.MinReassignments {
+			reason = TerminatedMinReassignments
+			break
+		}
+
+		if stop.Tolerance > 0 && shift < stop.Tolerance {
+			reason = TerminatedTolerance
+			break
+		}
+
+		if !moved {
+			reason = TerminatedConverged
+			break
+		}
 	}
+
+	return Info{
+		Iterations: iterations,
+		Inertia:    k.inertia(clusters),
+		Reason:     reason,
+	}
+}
+
+// inertia is the sum of squared distances between each point and the centroid of the cluster it
+// is currently assigned to.
+func (k *KMeans[T]) inertia(clusters []Cluster[T]) float64 {
+	total := 0.0
+
+	for i := range clusters {
+		for _, n := range clusters[i].Nodes {
+			d := k.distance(n, clusters[i].Centroid)
+			total += d * d
+		}
+	}
+
+	return total
 }
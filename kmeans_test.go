@@ -0,0 +1,188 @@
+package kmeans
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func floatDistance(a, b float64) float64 {
+	return math.Abs(a - b)
+}
+
+func floatMean(xs []float64) float64 {
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+func floatEquals(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// runWithTimeout fails the test instead of hanging forever if fn doesn't return in time. This is
+// a regression guard for the inverted convergence predicate that used to make partition loop
+// forever once the centroids stabilized.
+func runWithTimeout(t *testing.T, timeout time.Duration, fn func()) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		t.Fatalf("timed out after %s, likely an infinite loop", timeout)
+	}
+}
+
+func threeClusterData() []float64 {
+	return []float64{0, 0.1, -0.1, 10, 10.1, 9.9, 20, 20.1, 19.9}
+}
+
+func TestCalculateConverges(t *testing.T) {
+	data := threeClusterData()
+
+	k, err := NewKMeans(data, floatDistance, floatMean, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	var clusters []Cluster[float64]
+	var info Info
+	var calcErr error
+
+	runWithTimeout(t, 2*time.Second, func() {
+		clusters, info, calcErr = k.Calculate(3, StopCriteria{})
+	})
+
+	if calcErr != nil {
+		t.Fatalf("Calculate: %v", calcErr)
+	}
+
+	if len(clusters) != 3 {
+		t.Fatalf("got %d clusters, want 3", len(clusters))
+	}
+
+	if info.Reason != TerminatedConverged {
+		t.Fatalf("got termination reason %v, want TerminatedConverged", info.Reason)
+	}
+
+	total := 0
+	for _, c := range clusters {
+		total += len(c.Nodes)
+	}
+	if total != len(data) {
+		t.Fatalf("clusters hold %d points, want %d", total, len(data))
+	}
+}
+
+func TestCalculateStopCriteria(t *testing.T) {
+	data := threeClusterData()
+
+	k, err := NewKMeans(data, floatDistance, floatMean, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	_, info, err := k.Calculate(3, StopCriteria{MaxIterations: 1})
+	if err != nil {
+		t.Fatalf("Calculate: %v", err)
+	}
+	if info.Reason != TerminatedMaxIterations {
+		t.Fatalf("got termination reason %v, want TerminatedMaxIterations", info.Reason)
+	}
+	if info.Iterations != 1 {
+		t.Fatalf("got %d iterations, want 1", info.Iterations)
+	}
+}
+
+func TestCalculateSeedStrategies(t *testing.T) {
+	data := make([]float64, 200)
+	rnd := rand.New(rand.NewSource(1))
+	for i := range data {
+		data[i] = rnd.Float64() * 100
+	}
+
+	strategies := []SeedStrategy{SeedKMeansPlusPlus, SeedKMeansParallel}
+
+	for _, s := range strategies {
+		k, err := NewKMeans(data, floatDistance, floatMean, floatEquals, WithSeedStrategy[float64](s))
+		if err != nil {
+			t.Fatalf("NewKMeans: %v", err)
+		}
+
+		var clusters []Cluster[float64]
+		var calcErr error
+		runWithTimeout(t, 5*time.Second, func() {
+			clusters, _, calcErr = k.Calculate(5, StopCriteria{})
+		})
+
+		if calcErr != nil {
+			t.Fatalf("strategy %v: Calculate: %v", s, calcErr)
+		}
+
+		if len(clusters) != 5 {
+			t.Fatalf("strategy %v: got %d clusters, want 5", s, len(clusters))
+		}
+	}
+}
+
+func TestNewKMeansRejectsEmptyData(t *testing.T) {
+	if _, err := NewKMeans[float64](nil, floatDistance, floatMean, floatEquals); err == nil {
+		t.Fatal("expected an error for empty data, got nil")
+	}
+}
+
+func TestCalculateRejectsOutOfRangeK(t *testing.T) {
+	k, err := NewKMeans(threeClusterData(), floatDistance, floatMean, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	if _, _, err := k.Calculate(100, StopCriteria{}); err == nil {
+		t.Fatal("expected an error for numClusters > len(data), got nil")
+	}
+
+	if _, _, err := k.Calculate(0, StopCriteria{}); err == nil {
+		t.Fatal("expected an error for numClusters == 0, got nil")
+	}
+}
+
+// TestCalculateHandlesDuplicateCentroids is a regression test: seeding can pick two identical
+// centroids when the dataset has duplicate points, which leaves one cluster with an empty Nodes
+// slice. Calling floatMean on it would yield a NaN centroid that never compares equal to itself,
+// so partition would never converge.
+func TestCalculateHandlesDuplicateCentroids(t *testing.T) {
+	data := []float64{0, 0, 0, 0, 100, 100, 100, 100}
+
+	k, err := NewKMeans(data, floatDistance, floatMean, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	var clusters []Cluster[float64]
+	var calcErr error
+
+	runWithTimeout(t, 2*time.Second, func() {
+		clusters, _, calcErr = k.Calculate(3, StopCriteria{})
+	})
+
+	if calcErr != nil {
+		t.Fatalf("Calculate: %v", calcErr)
+	}
+
+	total := 0
+	for _, c := range clusters {
+		total += len(c.Nodes)
+	}
+	if total != len(data) {
+		t.Fatalf("clusters hold %d points, want %d", total, len(data))
+	}
+}
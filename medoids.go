@@ -0,0 +1,226 @@
+package kmeans
+
+import (
+	"errors"
+	"math"
+)
+
+// KMedoids clusters data using Partitioning Around Medoids (PAM). Unlike KMeans, it only requires
+// a DistanceFn and an EqualsFn: each cluster's representative is always one of the original data
+// points (a medoid), never a computed mean, which makes it usable for types that have no sensible
+// MeanFn (strings under edit distance, graph nodes, categorical vectors, ...).
+type KMedoids[T any] struct {
+	data     []T
+	distance DistanceFn[T]
+	equals   EqualsFn[T]
+}
+
+func NewKMedoids[T any](data []T, distance DistanceFn[T], equals EqualsFn[T]) (*KMedoids[T], error) {
+	if len(data) == 0 {
+		return nil, errors.New("no observations provided")
+	}
+
+	return &KMedoids[T]{
+		data:     data,
+		distance: distance,
+		equals:   equals,
+	}, nil
+}
+
+// Calculate runs PAM's BUILD phase to seed numClusters medoids, then FastPAM1's SWAP phase to
+// refine them, and returns the resulting clusters.
+func (k *KMedoids[T]) Calculate(numClusters uint) ([]Cluster[T], error) {
+	if numClusters == 0 || int(numClusters) > len(k.data) {
+		return nil, errors.New("numClusters must be between 1 and len(data)")
+	}
+
+	medoids := k.build(numClusters)
+	medoids = k.swap(medoids)
+
+	clusters := make([]Cluster[T], len(medoids))
+	for i, m := range medoids {
+		clusters[i] = Cluster[T]{Centroid: k.data[m]}
+	}
+
+	for i := range k.data {
+		nearest := k.nearestMedoid(k.data[i], medoids)
+		clusters[nearest].Nodes = append(clusters[nearest].Nodes, k.data[i])
+	}
+
+	return clusters, nil
+}
+
+// build greedily seeds numClusters medoids: the first is the point minimizing total distance to
+// all others, and each subsequent medoid is the remaining point that most reduces the total
+// assignment cost (the sum, over all points, of the distance to their nearest medoid so far).
+func (k *KMedoids[T]) build(numClusters uint) []int {
+	n := len(k.data)
+
+	first := -1
+	bestCost := math.MaxFloat64
+
+	for i := 0; i < n; i++ {
+		cost := 0.0
+		for j := 0; j < n; j++ {
+			if j != i {
+				cost += k.distance(k.data[i], k.data[j])
+			}
+		}
+
+		if cost < bestCost {
+			bestCost = cost
+			first = i
+		}
+	}
+
+	medoids := []int{first}
+
+	nearest := make([]float64, n)
+	for i := range k.data {
+		nearest[i] = k.distance(k.data[i], k.data[first])
+	}
+
+	for uint(len(medoids)) < numClusters {
+		candidate := -1
+		bestReduction := -math.MaxFloat64
+
+		for c := 0; c < n; c++ {
+			if in(c, medoids) {
+				continue
+			}
+
+			reduction := 0.0
+			for i := 0; i < n; i++ {
+				if d := k.distance(k.data[i], k.data[c]); d < nearest[i] {
+					reduction += nearest[i] - d
+				}
+			}
+
+			if reduction > bestReduction {
+				bestReduction = reduction
+				candidate = c
+			}
+		}
+
+		medoids = append(medoids, candidate)
+
+		for i := 0; i < n; i++ {
+			if d := k.distance(k.data[i], k.data[candidate]); d < nearest[i] {
+				nearest[i] = d
+			}
+		}
+	}
+
+	return medoids
+}
+
+// medoidState caches, for every point, the distance to its nearest and second-nearest medoid.
+// FastPAM1 reuses this cache so a single swap's cost delta can be evaluated in O(n) instead of the
+// O(n*k) a naive re-scan over all medoids would need.
+type medoidState struct {
+	nearestIdx  []int
+	nearestDist []float64
+	secondDist  []float64
+}
+
+func (k *KMedoids[T]) computeState(medoids []int) medoidState {
+	st := medoidState{
+		nearestIdx:  make([]int, len(k.data)),
+		nearestDist: make([]float64, len(k.data)),
+		secondDist:  make([]float64, len(k.data)),
+	}
+
+	for i := range k.data {
+		d1, d2 := math.MaxFloat64, math.MaxFloat64
+		idx := -1
+
+		for mi, m := range medoids {
+			d := k.distance(k.data[i], k.data[m])
+
+			if d < d1 {
+				d2 = d1
+				d1 = d
+				idx = mi
+			} else if d < d2 {
+				d2 = d
+			}
+		}
+
+		st.nearestIdx[i] = idx
+		st.nearestDist[i] = d1
+		st.secondDist[i] = d2
+	}
+
+	return st
+}
+
+// swap repeatedly applies the best improving (medoid, non-medoid) swap it can find, evaluating
+// each candidate pair's cost delta against the cached medoidState, until no swap reduces the
+// total cost.
+func (k *KMedoids[T]) swap(medoids []int) []int {
+	medoids = append([]int(nil), medoids...)
+	st := k.computeState(medoids)
+
+	for {
+		bestDelta := 0.0
+		bestM, bestH := -1, -1
+
+		for mi := range medoids {
+			for h := 0; h < len(k.data); h++ {
+				if in(h, medoids) {
+					continue
+				}
+
+				delta := 0.0
+
+				for i := range k.data {
+					dih := k.distance(k.data[i], k.data[h])
+
+					if st.nearestIdx[i] == mi {
+						delta += math.Min(dih, st.secondDist[i]) - st.nearestDist[i]
+					} else if dih < st.nearestDist[i] {
+						delta += dih - st.nearestDist[i]
+					}
+				}
+
+				if delta < bestDelta {
+					bestDelta = delta
+					bestM, bestH = mi, h
+				}
+			}
+		}
+
+		if bestH == -1 {
+			break
+		}
+
+		medoids[bestM] = bestH
+		st = k.computeState(medoids)
+	}
+
+	return medoids
+}
+
+func (k *KMedoids[T]) nearestMedoid(x T, medoids []int) int {
+	best := math.MaxFloat64
+	idx := -1
+
+	for mi, m := range medoids {
+		if d := k.distance(x, k.data[m]); d < best {
+			best = d
+			idx = mi
+		}
+	}
+
+	return idx
+}
+
+func in(i int, p []int) bool {
+	for _, s := range p {
+		if s == i {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package kmeans
+
+import "testing"
+
+func TestKMedoidsCalculate(t *testing.T) {
+	data := []float64{0, 0.1, -0.1, 10, 10.1, 9.9}
+
+	k, err := NewKMedoids(data, floatDistance, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMedoids: %v", err)
+	}
+
+	clusters, err := k.Calculate(2)
+	if err != nil {
+		t.Fatalf("Calculate: %v", err)
+	}
+
+	if len(clusters) != 2 {
+		t.Fatalf("got %d clusters, want 2", len(clusters))
+	}
+
+	total := 0
+	for _, c := range clusters {
+		total += len(c.Nodes)
+	}
+	if total != len(data) {
+		t.Fatalf("clusters hold %d points, want %d", total, len(data))
+	}
+}
+
+// TestKMedoidsCalculateRejectsOutOfRangeK is a regression test: numClusters greater than
+// len(data) used to leave build's candidate index at -1 and panic on k.data[-1].
+func TestKMedoidsCalculateRejectsOutOfRangeK(t *testing.T) {
+	data := []float64{1, 2, 3}
+
+	k, err := NewKMedoids(data, floatDistance, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMedoids: %v", err)
+	}
+
+	if _, err := k.Calculate(5); err == nil {
+		t.Fatal("expected an error for numClusters > len(data), got nil")
+	}
+
+	if _, err := k.Calculate(0); err == nil {
+		t.Fatal("expected an error for numClusters == 0, got nil")
+	}
+}
@@ -0,0 +1,128 @@
+package kmeans
+
+import (
+	"runtime"
+	"sync"
+)
+
+// mapChunks splits [0, n) into runtime.NumCPU() contiguous chunks, runs fn over each chunk
+// concurrently, and returns their results once every worker has finished. Result order is not
+// guaranteed to match chunk order.
+func mapChunks[R any](n int, fn func(start, end int) R) []R {
+	workers := runtime.NumCPU()
+	if workers > n {
+		workers = n
+	}
+	if workers <= 0 {
+		return nil
+	}
+
+	chunk := (n + workers - 1) / workers
+
+	results := make([]R, 0, workers)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		start := w * chunk
+		if start >= n {
+			break
+		}
+
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+
+			r := fn(start, end)
+
+			mu.Lock()
+			results = append(results, r)
+			mu.Unlock()
+		}(start, end)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+type assignResult[T any] struct {
+	nodes      [][]T
+	reassigned int
+}
+
+// assign splits k.data into runtime.NumCPU() chunks (via mapChunks), assigns each chunk's
+// points to their nearest cluster on its own worker, then merges the per-chunk results into
+// clusters back on the caller's goroutine. assignments[i] is updated in place with the cluster
+// index k.data[i] now belongs to; it returns how many points changed cluster.
+func (k *KMeans[T]) assign(clusters []Cluster[T], assignments []int) int {
+	results := mapChunks(len(k.data), func(start, end int) assignResult[T] {
+		local := make([][]T, len(clusters))
+		reassigned := 0
+
+		for i := start; i < end; i++ {
+			nearestClusterIdx := k.nearestCluster(k.data[i], clusters)
+			local[nearestClusterIdx] = append(local[nearestClusterIdx], k.data[i])
+
+			if assignments[i] != nearestClusterIdx {
+				assignments[i] = nearestClusterIdx
+				reassigned++
+			}
+		}
+
+		return assignResult[T]{nodes: local, reassigned: reassigned}
+	})
+
+	total := 0
+
+	for _, r := range results {
+		for ci, nodes := range r.nodes {
+			clusters[ci].Nodes = append(clusters[ci].Nodes, nodes...)
+		}
+
+		total += r.reassigned
+	}
+
+	return total
+}
+
+type meanPartial[T any] struct {
+	acc   T
+	count int
+}
+
+// updateCentroid computes a cluster's new centroid from nodes, which the caller (partition) must
+// guarantee is non-empty: a cluster that lost every point to a duplicate centroid elsewhere keeps
+// its previous centroid instead of calling this. If an IncrementalMeanFn was supplied via
+// WithIncrementalMeanFn, nodes is split into chunks whose partial sums are folded in parallel and
+// then combined; otherwise it falls back to calling MeanFn once over the full slice.
+func (k *KMeans[T]) updateCentroid(nodes []T) T {
+	if k.incrementalMean == nil || len(nodes) == 0 {
+		return k.mean(nodes)
+	}
+
+	partials := mapChunks(len(nodes), func(start, end int) meanPartial[T] {
+		acc := nodes[start]
+
+		for i := start + 1; i < end; i++ {
+			acc = k.incrementalMean.Add(acc, nodes[i])
+		}
+
+		return meanPartial[T]{acc: acc, count: end - start}
+	})
+
+	total := partials[0].acc
+	count := partials[0].count
+
+	for _, p := range partials[1:] {
+		total = k.incrementalMean.Add(total, p.acc)
+		count += p.count
+	}
+
+	return k.incrementalMean.Finalize(total, count)
+}
@@ -0,0 +1,65 @@
+package kmeans
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestAssignMatchesSequentialNearestCluster(t *testing.T) {
+	data := make([]float64, 500)
+	rnd := rand.New(rand.NewSource(3))
+	for i := range data {
+		data[i] = rnd.Float64() * 1000
+	}
+
+	k, err := NewKMeans(data, floatDistance, floatMean, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	clusters := []Cluster[float64]{{Centroid: 10}, {Centroid: 500}, {Centroid: 900}}
+	assignments := make([]int, len(data))
+	for i := range assignments {
+		assignments[i] = -1
+	}
+
+	k.assign(clusters, assignments)
+
+	for i, x := range data {
+		want := k.nearestCluster(x, clusters)
+		if assignments[i] != want {
+			t.Fatalf("point %d: assign() picked cluster %d, nearestCluster wants %d", i, assignments[i], want)
+		}
+	}
+}
+
+// benchmarkData builds a dataset large enough for the assignment step's worker-pool parallelism
+// to show up over its per-goroutine overhead.
+func benchmarkData(n int) []float64 {
+	data := make([]float64, n)
+	rnd := rand.New(rand.NewSource(4))
+	for i := range data {
+		data[i] = rnd.Float64() * float64(n)
+	}
+	return data
+}
+
+// BenchmarkCalculate exercises the full assign/update loop on a 100k-point dataset; no
+// IncrementalMeanFn is set, so only the assignment step runs on the worker pool. Run it with
+// `go test -bench=BenchmarkCalculate -cpu=1,2,4,8` to compare per-op time across core counts.
+func BenchmarkCalculate(b *testing.B) {
+	data := benchmarkData(100_000)
+
+	k, err := NewKMeans(data, floatDistance, floatMean, floatEquals)
+	if err != nil {
+		b.Fatalf("NewKMeans: %v", err)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, _, err := k.Calculate(8, StopCriteria{MaxIterations: 10}); err != nil {
+			b.Fatalf("Calculate: %v", err)
+		}
+	}
+}
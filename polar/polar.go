@@ -0,0 +1,52 @@
+// Package polar provides a Point type for angular values in radians, along with distance and
+// mean functions that correctly handle wraparound at +/-pi, which the arithmetic mean in the
+// vec package gets wrong (the mean of -pi+0.01 and pi-0.01 should be pi, not 0).
+package polar
+
+import (
+	"math"
+
+	"github.com/huysamen/kmeans"
+)
+
+// Point is an angle in radians.
+type Point float64
+
+// Distance returns the shortest angular distance between a and b, in [0, pi].
+func Distance(a, b Point) float64 {
+	d := math.Mod(float64(a-b), 2*math.Pi)
+
+	if d > math.Pi {
+		d -= 2 * math.Pi
+	} else if d < -math.Pi {
+		d += 2 * math.Pi
+	}
+
+	return math.Abs(d)
+}
+
+// Mean returns the circular mean of points: the angle of the average of their unit vectors.
+func Mean(points []Point) Point {
+	sinSum, cosSum := 0.0, 0.0
+
+	for _, p := range points {
+		sinSum += math.Sin(float64(p))
+		cosSum += math.Cos(float64(p))
+	}
+
+	return Point(math.Atan2(sinSum/float64(len(points)), cosSum/float64(len(points))))
+}
+
+// ApproxEqual returns an EqualsFn that treats two angles as equal once their angular distance is
+// no more than tol.
+func ApproxEqual(tol float64) kmeans.EqualsFn[Point] {
+	return func(a, b Point) bool {
+		return Distance(a, b) <= tol
+	}
+}
+
+// NewKMeans builds a *kmeans.KMeans[Point] wired up with Distance, Mean, and an ApproxEqual
+// tolerance of 1e-9.
+func NewKMeans(data []Point, opts ...kmeans.Option[Point]) (*kmeans.KMeans[Point], error) {
+	return kmeans.NewKMeans(data, Distance, Mean, ApproxEqual(1e-9), opts...)
+}
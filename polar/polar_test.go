@@ -0,0 +1,52 @@
+package polar_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/huysamen/kmeans"
+	"github.com/huysamen/kmeans/polar"
+)
+
+func TestDistanceAndMeanWraparound(t *testing.T) {
+	a := polar.Point(-math.Pi + 0.01)
+	b := polar.Point(math.Pi - 0.01)
+
+	if d := polar.Distance(a, b); d > 0.03 {
+		t.Fatalf("Distance(%v, %v) = %v, want ~0.02", a, b, d)
+	}
+
+	mean := polar.Mean([]polar.Point{a, b})
+	if d := polar.Distance(mean, math.Pi); d > 0.03 {
+		t.Fatalf("Mean(%v, %v) = %v, want ~pi", a, b, mean)
+	}
+}
+
+// TestCalculateWithDuplicatePoints is a regression test, mirroring the vec package's: duplicate
+// points used to let seeding pick the same centroid twice, leaving one cluster with zero nodes
+// and silently yielding a NaN centroid from Mean on the update step.
+func TestCalculateWithDuplicatePoints(t *testing.T) {
+	data := []polar.Point{0, 0, 0, 0, 2, 2, 2, 2}
+
+	k, err := polar.NewKMeans(data)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	clusters, _, err := k.Calculate(3, kmeans.StopCriteria{})
+	if err != nil {
+		t.Fatalf("Calculate: %v", err)
+	}
+
+	total := 0
+	for _, c := range clusters {
+		total += len(c.Nodes)
+
+		if math.IsNaN(float64(c.Centroid)) {
+			t.Fatalf("cluster centroid is NaN")
+		}
+	}
+	if total != len(data) {
+		t.Fatalf("clusters hold %d points, want %d", total, len(data))
+	}
+}
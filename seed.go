@@ -0,0 +1,281 @@
+package kmeans
+
+import (
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+func (k *KMeans[T]) initClusters(numClusters uint) []Cluster[T] {
+	switch k.seedStrategy {
+	case SeedKMeansParallel:
+		return k.initKMeansParallel(numClusters)
+	default:
+		return k.initKMeansPlusPlus(numClusters)
+	}
+}
+
+// initKMeansPlusPlus implements the standard k-means++ seeding algorithm: centers are chosen one
+// at a time, sequentially, each with probability proportional to its squared distance from the
+// nearest center already chosen.
+func (k *KMeans[T]) initKMeansPlusPlus(numClusters uint) []Cluster[T] {
+	clusters := make([]Cluster[T], 0, numClusters)
+
+	// 1 -	Choose one center uniformly at random among the data points.
+	first := k.rnd.Intn(len(k.data))
+	clusters = append(clusters, Cluster[T]{Centroid: k.data[first]})
+
+	// nearest[i] caches the distance from k.data[i] to the closest centroid chosen so far, so each
+	// round only needs to compare against the centroid that was just added (kppFaster) instead of
+	// rescanning every chosen centroid.
+	nearest := make([]float64, len(k.data))
+	for i := range k.data {
+		nearest[i] = k.distance(k.data[i], clusters[0].Centroid)
+	}
+	nearest[first] = 0
+
+	cumulative := make([]float64, len(k.data))
+
+	for uint(len(clusters)) < numClusters {
+		// 2 -	For each data point x not chosen yet, compute D(x), the distance between x and the nearest
+		//		center that has already been chosen, and form the cumulative sum of D(x)2.
+		total := 0.0
+
+		for i, d := range nearest {
+			total += d * d
+			cumulative[i] = total
+		}
+
+		// 3 -	Choose one new data point at random as a new center, using a weighted probability
+		//		distribution where a point x is chosen with probability proportional to D(x)2.
+		r := k.rnd.Float64() * total
+		next := sort.Search(len(cumulative), func(i int) bool { return cumulative[i] >= r })
+
+		clusters = append(clusters, Cluster[T]{Centroid: k.data[next]})
+
+		// The new centroid can only shrink a point's nearest distance, never grow it.
+		for i, x := range k.data {
+			if d := k.distance(x, k.data[next]); d < nearest[i] {
+				nearest[i] = d
+			}
+		}
+		nearest[next] = 0
+	} // 4 - Repeat Steps 2 and 3 until k centers have been chosen.
+
+	// 5 -	Now that the initial centers have been chosen, proceed using standard k-means clustering.
+	return clusters
+}
+
+// initKMeansParallel implements Bahmani et al.'s k-means|| seeding algorithm. A single center is
+// chosen uniformly at random, then for a fixed number of rounds every remaining point is sampled
+// independently with probability proportional to l times its squared distance to the nearest
+// center chosen so far, divided by the current total squared distance (phi). This yields O(k log n)
+// candidate centers, which are then weighted by the number of data points they are nearest to and
+// reduced to exactly numClusters centers via a local weighted k-means++ pass.
+func (k *KMeans[T]) initKMeansParallel(numClusters uint) []Cluster[T] {
+	l := k.oversampling
+	if l <= 0 {
+		l = 2 * float64(numClusters)
+	}
+
+	rounds := k.rounds
+	if rounds <= 0 {
+		rounds = 5
+	}
+
+	first := k.rnd.Intn(len(k.data))
+	candidates := []int{first}
+
+	nearest := make([]float64, len(k.data))
+	for i := range nearest {
+		nearest[i] = math.MaxFloat64
+	}
+	k.updateNearest(nearest, candidates)
+
+	for r := 0; r < rounds; r++ {
+		phi := 0.0
+		for _, d := range nearest {
+			phi += d * d
+		}
+
+		if phi == 0 {
+			break
+		}
+
+		var sampled []int
+		for i, d := range nearest {
+			p := math.Min(1, l*d*d/phi)
+			if k.rnd.Float64() < p {
+				sampled = append(sampled, i)
+			}
+		}
+
+		if len(sampled) == 0 {
+			continue
+		}
+
+		candidates = append(candidates, sampled...)
+		k.updateNearest(nearest, sampled)
+	}
+
+	// The oversampling rounds are probabilistic and, especially for small datasets or a small l,
+	// may not produce numClusters candidates. Top up the pool deterministically so the reduction
+	// below can always return exactly numClusters centers, as documented.
+	for uint(len(candidates)) < numClusters {
+		next := -1
+		best := -1.0
+
+		for i, d := range nearest {
+			if d > best {
+				best = d
+				next = i
+			}
+		}
+
+		if next == -1 {
+			break
+		}
+
+		candidates = append(candidates, next)
+		k.updateNearest(nearest, []int{next})
+	}
+
+	weights := k.candidateWeights(candidates)
+
+	return k.weightedKMeansPlusPlus(candidates, weights, numClusters)
+}
+
+// updateNearest lowers nearest[i] to the distance between k.data[i] and the nearest point in
+// newCenters, for every i, parallelizing the scan across a worker pool sized to runtime.NumCPU().
+func (k *KMeans[T]) updateNearest(nearest []float64, newCenters []int) {
+	forEachChunk(len(k.data), func(start, end int) {
+		for i := start; i < end; i++ {
+			for _, c := range newCenters {
+				if d := k.distance(k.data[i], k.data[c]); d < nearest[i] {
+					nearest[i] = d
+				}
+			}
+		}
+	})
+}
+
+// candidateWeights counts, for every candidate, how many data points it is the nearest candidate
+// to. These counts are the weights used by the reduction pass in weightedKMeansPlusPlus.
+func (k *KMeans[T]) candidateWeights(candidates []int) []float64 {
+	weights := make([]float64, len(candidates))
+	var mu sync.Mutex
+
+	forEachChunk(len(k.data), func(start, end int) {
+		local := make([]float64, len(candidates))
+
+		for i := start; i < end; i++ {
+			best := math.MaxFloat64
+			bestCandidate := -1
+
+			for c, ci := range candidates {
+				if d := k.distance(k.data[i], k.data[ci]); d < best {
+					best = d
+					bestCandidate = c
+				}
+			}
+
+			local[bestCandidate]++
+		}
+
+		mu.Lock()
+		for i, w := range local {
+			weights[i] += w
+		}
+		mu.Unlock()
+	})
+
+	return weights
+}
+
+// weightedKMeansPlusPlus reduces a weighted set of candidate centers down to exactly numClusters
+// centers, running the same sequential D(x)2 sampling as initKMeansPlusPlus but multiplying each
+// candidate's squared distance by its weight.
+func (k *KMeans[T]) weightedKMeansPlusPlus(candidates []int, weights []float64, numClusters uint) []Cluster[T] {
+	n := len(candidates)
+	cumulative := make([]float64, n)
+
+	total := 0.0
+	for i, w := range weights {
+		total += w
+		cumulative[i] = total
+	}
+
+	r := k.rnd.Float64() * total
+	first := sort.Search(n, func(i int) bool { return cumulative[i] >= r })
+
+	chosen := make([]int, 0, numClusters)
+	chosen = append(chosen, first)
+
+	nearest := make([]float64, n)
+	for i, ci := range candidates {
+		nearest[i] = k.distance(k.data[ci], k.data[candidates[first]])
+	}
+	nearest[first] = 0
+
+	for uint(len(chosen)) < numClusters && len(chosen) < n {
+		total = 0.0
+		for i, d := range nearest {
+			total += weights[i] * d * d
+			cumulative[i] = total
+		}
+
+		r := k.rnd.Float64() * total
+		next := sort.Search(n, func(i int) bool { return cumulative[i] >= r })
+		chosen = append(chosen, next)
+
+		for i, ci := range candidates {
+			if d := k.distance(k.data[ci], k.data[candidates[next]]); d < nearest[i] {
+				nearest[i] = d
+			}
+		}
+		nearest[next] = 0
+	}
+
+	clusters := make([]Cluster[T], 0, len(chosen))
+	for _, c := range chosen {
+		clusters = append(clusters, Cluster[T]{Centroid: k.data[candidates[c]]})
+	}
+
+	return clusters
+}
+
+// forEachChunk splits [0, n) into runtime.NumCPU() contiguous chunks and runs fn over each chunk
+// concurrently, waiting for every worker to finish before returning.
+func forEachChunk(n int, fn func(start, end int)) {
+	workers := runtime.NumCPU()
+	if workers > n {
+		workers = n
+	}
+	if workers <= 0 {
+		return
+	}
+
+	chunk := (n + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * chunk
+		if start >= n {
+			break
+		}
+
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			fn(start, end)
+		}(start, end)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,220 @@
+package kmeans
+
+import (
+	"errors"
+	"math"
+)
+
+// KSelectionMethod selects the scoring method SelectK uses to judge each candidate k.
+type KSelectionMethod int
+
+const (
+	// KSelectionElbow scores each k by how far its inertia sits from the straight line joining
+	// the inertia at the smallest and largest candidate k (the "knee" heuristic).
+	KSelectionElbow KSelectionMethod = iota
+
+	// KSelectionSilhouette scores each k by the mean silhouette coefficient over all points.
+	KSelectionSilhouette
+
+	// KSelectionGap scores each k by the gap statistic: the expected inertia under a uniform
+	// reference distribution minus the observed inertia, both in log space. Requires
+	// WithRandomPointFn to be set.
+	KSelectionGap
+)
+
+// RandomPointFn generates a single random reference point, used by KSelectionGap to build a null
+// reference distribution. Implementations typically sample uniformly within the bounding box of
+// the original data.
+type RandomPointFn[T any] func() T
+
+// WithRandomPointFn supplies the reference-point generator required by KSelectionGap. It has no
+// effect with other selection methods.
+func WithRandomPointFn[T any](fn RandomPointFn[T]) Option[T] {
+	return func(k *KMeans[T]) {
+		k.randomPoint = fn
+	}
+}
+
+// gapReferenceRounds is the number of reference datasets sampled per k when estimating the
+// expected inertia term of the gap statistic.
+const gapReferenceRounds = 10
+
+// SelectK runs Calculate for every k in [min, max] and picks the best one according to method. It
+// returns that k, the clusters Calculate produced for it, and the per-k scores method computed,
+// indexed from 0 (scores[0] is the score for k == min).
+//
+// SelectK requires 1 <= min <= max, and requires WithRandomPointFn to have been set on k when
+// method is KSelectionGap; otherwise it returns an error instead of a meaningless result.
+func (k *KMeans[T]) SelectK(min, max uint, method KSelectionMethod) (uint, []Cluster[T], []float64, error) {
+	if min < 1 || min > max {
+		return 0, nil, nil, errors.New("SelectK requires 1 <= min <= max")
+	}
+
+	if method == KSelectionGap && k.randomPoint == nil {
+		return 0, nil, nil, errors.New("SelectK: KSelectionGap requires WithRandomPointFn to be set")
+	}
+
+	n := int(max-min) + 1
+
+	allClusters := make([][]Cluster[T], n)
+	inertias := make([]float64, n)
+
+	for i := 0; i < n; i++ {
+		clusters, info, err := k.Calculate(min+uint(i), StopCriteria{})
+		if err != nil {
+			return 0, nil, nil, err
+		}
+
+		allClusters[i] = clusters
+		inertias[i] = info.Inertia
+	}
+
+	var scores []float64
+
+	switch method {
+	case KSelectionSilhouette:
+		scores = make([]float64, n)
+		for i := range allClusters {
+			scores[i] = k.silhouette(allClusters[i])
+		}
+	case KSelectionGap:
+		scores = make([]float64, n)
+		for i := range inertias {
+			scores[i] = k.gapStatistic(min+uint(i), inertias[i])
+		}
+	default:
+		scores = elbowScores(inertias)
+	}
+
+	best := 0
+	for i := 1; i < n; i++ {
+		if scores[i] > scores[best] {
+			best = i
+		}
+	}
+
+	return min + uint(best), allClusters[best], scores, nil
+}
+
+// elbowScores scores each candidate k by its perpendicular distance from the line joining the
+// first and last (k, inertia) points. The largest distance is the "knee" of the curve.
+func elbowScores(inertias []float64) []float64 {
+	n := len(inertias)
+	scores := make([]float64, n)
+
+	x1, y1 := 0.0, inertias[0]
+	x2, y2 := float64(n-1), inertias[n-1]
+
+	dx, dy := x2-x1, y2-y1
+	norm := math.Sqrt(dx*dx + dy*dy)
+
+	if norm == 0 {
+		return scores
+	}
+
+	for i, y0 := range inertias {
+		x0 := float64(i)
+		scores[i] = math.Abs(dy*x0-dx*y0+x2*y1-y2*x1) / norm
+	}
+
+	return scores
+}
+
+// silhouette computes the mean silhouette coefficient over every point: for each point, a is the
+// mean distance to other points in its own cluster and b is the smallest mean distance to the
+// points of any other cluster; the point's score is (b-a)/max(a,b).
+func (k *KMeans[T]) silhouette(clusters []Cluster[T]) float64 {
+	if len(clusters) < 2 {
+		return 0
+	}
+
+	total := 0.0
+	count := 0
+
+	for i := range clusters {
+		nodes := clusters[i].Nodes
+
+		for pi, p := range nodes {
+			a := 0.0
+			if len(nodes) > 1 {
+				sum := 0.0
+				for qi, q := range nodes {
+					if qi == pi {
+						continue
+					}
+					sum += k.distance(p, q)
+				}
+				a = sum / float64(len(nodes)-1)
+			}
+
+			b := math.MaxFloat64
+			for j := range clusters {
+				if j == i || len(clusters[j].Nodes) == 0 {
+					continue
+				}
+
+				sum := 0.0
+				for _, q := range clusters[j].Nodes {
+					sum += k.distance(p, q)
+				}
+
+				if d := sum / float64(len(clusters[j].Nodes)); d < b {
+					b = d
+				}
+			}
+
+			denom := math.Max(a, b)
+			if denom == 0 {
+				continue
+			}
+
+			total += (b - a) / denom
+			count++
+		}
+	}
+
+	if count == 0 {
+		return 0
+	}
+
+	return total / float64(count)
+}
+
+// gapStatistic estimates Tibshirani et al.'s gap statistic for numClusters: the expected log
+// inertia under a uniform reference distribution (averaged over gapReferenceRounds samples) minus
+// the observed log inertia. A larger gap means the observed clustering is tighter than chance
+// would predict.
+func (k *KMeans[T]) gapStatistic(numClusters uint, inertia float64) float64 {
+	if k.randomPoint == nil || inertia <= 0 {
+		return 0
+	}
+
+	expected := 0.0
+	rounds := 0
+
+	for r := 0; r < gapReferenceRounds; r++ {
+		reference := make([]T, len(k.data))
+		for i := range reference {
+			reference[i] = k.randomPoint()
+		}
+
+		ref, err := NewKMeans(reference, k.distance, k.mean, k.equals)
+		if err != nil {
+			continue
+		}
+
+		_, info, err := ref.Calculate(numClusters, StopCriteria{})
+		if err != nil || info.Inertia <= 0 {
+			continue
+		}
+
+		expected += math.Log(info.Inertia)
+		rounds++
+	}
+
+	if rounds == 0 {
+		return 0
+	}
+
+	return expected/float64(rounds) - math.Log(inertia)
+}
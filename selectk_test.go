@@ -0,0 +1,64 @@
+package kmeans
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func selectKTestData() []float64 {
+	data := make([]float64, 60)
+	rnd := rand.New(rand.NewSource(2))
+	for i := range data {
+		center := float64((i / 20) * 50)
+		data[i] = center + rnd.Float64()*5
+	}
+	return data
+}
+
+func TestSelectKElbow(t *testing.T) {
+	k, err := NewKMeans(selectKTestData(), floatDistance, floatMean, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	best, clusters, scores, err := k.SelectK(2, 5, KSelectionElbow)
+	if err != nil {
+		t.Fatalf("SelectK: %v", err)
+	}
+
+	if best < 2 || best > 5 {
+		t.Fatalf("got best k %d, want it in [2, 5]", best)
+	}
+	if len(clusters) != int(best) {
+		t.Fatalf("got %d clusters, want %d", len(clusters), best)
+	}
+	if len(scores) != 4 {
+		t.Fatalf("got %d scores, want 4", len(scores))
+	}
+}
+
+func TestSelectKRejectsInvertedRange(t *testing.T) {
+	k, err := NewKMeans(selectKTestData(), floatDistance, floatMean, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	if _, _, _, err := k.SelectK(5, 3, KSelectionElbow); err == nil {
+		t.Fatal("expected an error for min > max, got nil")
+	}
+
+	if _, _, _, err := k.SelectK(0, 3, KSelectionElbow); err == nil {
+		t.Fatal("expected an error for min == 0, got nil")
+	}
+}
+
+func TestSelectKGapRequiresRandomPointFn(t *testing.T) {
+	k, err := NewKMeans(selectKTestData(), floatDistance, floatMean, floatEquals)
+	if err != nil {
+		t.Fatalf("NewKMeans: %v", err)
+	}
+
+	if _, _, _, err := k.SelectK(2, 3, KSelectionGap); err == nil {
+		t.Fatal("expected an error for KSelectionGap without WithRandomPointFn, got nil")
+	}
+}
@@ -0,0 +1,95 @@
+// Package vec provides a ready-made Point type and the distance/mean functions needed to cluster
+// points in R^n, wired to the generic kmeans package.
+package vec
+
+import (
+	"math"
+
+	"github.com/huysamen/kmeans"
+)
+
+// Point is a point in R^n.
+type Point []float64
+
+// SquaredEuclideanDistance returns the squared Euclidean distance between a and b.
+func SquaredEuclideanDistance(a, b Point) float64 {
+	sum := 0.0
+
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+
+	return sum
+}
+
+// EuclideanDistance returns the Euclidean distance between a and b.
+func EuclideanDistance(a, b Point) float64 {
+	return math.Sqrt(SquaredEuclideanDistance(a, b))
+}
+
+// ManhattanDistance returns the L1 (taxicab) distance between a and b.
+func ManhattanDistance(a, b Point) float64 {
+	sum := 0.0
+
+	for i := range a {
+		sum += math.Abs(a[i] - b[i])
+	}
+
+	return sum
+}
+
+// CosineDistance returns 1 minus the cosine similarity between a and b. Two points pointing in
+// the same direction have distance 0; orthogonal points have distance 1.
+func CosineDistance(a, b Point) float64 {
+	dot, na, nb := 0.0, 0.0, 0.0
+
+	for i := range a {
+		dot += a[i] * b[i]
+		na += a[i] * a[i]
+		nb += b[i] * b[i]
+	}
+
+	if na == 0 || nb == 0 {
+		return 1
+	}
+
+	return 1 - dot/(math.Sqrt(na)*math.Sqrt(nb))
+}
+
+// ArithmeticMean returns the componentwise mean of points. It panics if points is empty.
+func ArithmeticMean(points []Point) Point {
+	mean := make(Point, len(points[0]))
+
+	for _, p := range points {
+		for i, v := range p {
+			mean[i] += v
+		}
+	}
+
+	for i := range mean {
+		mean[i] /= float64(len(points))
+	}
+
+	return mean
+}
+
+// ApproxEqual returns an EqualsFn that treats two points as equal once every component differs
+// by no more than tol.
+func ApproxEqual(tol float64) kmeans.EqualsFn[Point] {
+	return func(a, b Point) bool {
+		for i := range a {
+			if math.Abs(a[i]-b[i]) > tol {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// NewEuclidean builds a *kmeans.KMeans[Point] wired up with EuclideanDistance, ArithmeticMean,
+// and an ApproxEqual tolerance of 1e-9.
+func NewEuclidean(data []Point, opts ...kmeans.Option[Point]) (*kmeans.KMeans[Point], error) {
+	return kmeans.NewKMeans(data, EuclideanDistance, ArithmeticMean, ApproxEqual(1e-9), opts...)
+}
@@ -0,0 +1,54 @@
+package vec_test
+
+import (
+	"testing"
+
+	"github.com/huysamen/kmeans"
+	"github.com/huysamen/kmeans/vec"
+)
+
+func TestEuclideanDistanceMeanApproxEqual(t *testing.T) {
+	a := vec.Point{0, 0}
+	b := vec.Point{3, 4}
+
+	if got := vec.EuclideanDistance(a, b); got != 5 {
+		t.Fatalf("EuclideanDistance = %v, want 5", got)
+	}
+
+	mean := vec.ArithmeticMean([]vec.Point{{0, 0}, {2, 4}})
+	if mean[0] != 1 || mean[1] != 2 {
+		t.Fatalf("ArithmeticMean = %v, want [1 2]", mean)
+	}
+
+	if !vec.ApproxEqual(1e-6)(vec.Point{1, 1}, vec.Point{1, 1}) {
+		t.Fatal("ApproxEqual(1e-6) should treat identical points as equal")
+	}
+}
+
+// TestCalculateWithDuplicatePoints is a regression test: clustering data containing duplicate
+// points used to let seeding pick the same point twice as a centroid, leaving one cluster with
+// zero nodes and panicking inside ArithmeticMean on the update step.
+func TestCalculateWithDuplicatePoints(t *testing.T) {
+	data := []vec.Point{
+		{0, 0}, {0, 0}, {0, 0}, {0, 0},
+		{100, 100}, {100, 100}, {100, 100}, {100, 100},
+	}
+
+	k, err := vec.NewEuclidean(data)
+	if err != nil {
+		t.Fatalf("NewEuclidean: %v", err)
+	}
+
+	clusters, _, err := k.Calculate(3, kmeans.StopCriteria{})
+	if err != nil {
+		t.Fatalf("Calculate: %v", err)
+	}
+
+	total := 0
+	for _, c := range clusters {
+		total += len(c.Nodes)
+	}
+	if total != len(data) {
+		t.Fatalf("clusters hold %d points, want %d", total, len(data))
+	}
+}